@@ -0,0 +1,61 @@
+//go:build linux
+// +build linux
+
+package l7flow
+
+import (
+	"debug/buildinfo"
+	"regexp"
+)
+
+// RegexpGoBinary matches every newly mapped executable; whether a given path
+// is actually a Go binary is decided inside goTLSRegister by inspecting the
+// ELF .go.buildinfo section, since there is no filename convention to match
+// on like libssl.so.
+var RegexpGoBinary = regexp.MustCompile(`.`)
+
+// goBuildInfo is the subset of debug/buildinfo.BuildInfo this package cares
+// about when deciding whether a binary is worth probing for Go TLS.
+type goBuildInfo struct {
+	GoVersion string
+}
+
+// readGoBuildInfo reports whether path is a Go-compiled executable by
+// reading its .go.buildinfo ELF section (via the standard library, which
+// already knows how to locate that section across the supported ELF/PE/
+// Mach-O layouts). It intentionally does not fall back to scanning for the
+// runtime.buildVersion symbol: stripped binaries without .go.buildinfo are
+// not supported yet.
+func readGoBuildInfo(path string) (*goBuildInfo, bool) {
+	bi, err := buildinfo.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	return &goBuildInfo{GoVersion: bi.GoVersion}, true
+}
+
+// goTLSRegister is the Register func for the Go-binary UprobeRegRule. It
+// only detects and logs Go binaries for now: actually attaching uprobes to
+// crypto/tls.(*Conn).Read/.Write additionally needs offset resolution
+// (DWARF, or symbol table with a per-Go-version fallback table for stripped
+// binaries), an arch-specific disassembler to locate RET sites (Go's
+// register-based ABI (>=1.17) and goroutine rescheduling make uretprobes
+// unreliable), and a matching eBPF program under internal/c. None of that
+// exists yet, so no probe is attached here.
+func goTLSRegister(path string, pid int) error {
+	bi, ok := readGoBuildInfo(path)
+	if !ok {
+		return nil
+	}
+
+	log.Infof("go tls: detected Go binary %s (pid %d, %s); uprobe attach not yet implemented",
+		path, pid, bi.GoVersion)
+
+	return nil
+}
+
+// goTLSUnregister is the matching UnRegister func. It is a no-op: goTLSRegister
+// never attaches anything yet, so there is nothing to detach.
+func goTLSUnregister(path string, pid int) error {
+	return nil
+}
@@ -65,7 +65,7 @@ var (
 	RegexpLibSSL    = regexp.MustCompile(`libssl.so`)
 	RegexpLibCrypto = regexp.MustCompile(`libcrypto.so`)
 
-	// TODO: guntls
+	// Go TLS (crypto/tls): see gotls.go
 )
 
 type (
@@ -169,7 +169,7 @@ type perferEventHandle func(cpu int, data []byte, perfmap *manager.PerfMap,
 	manager *manager.Manager)
 
 func NewHTTPFlowManger(constEditor []manager.ConstantEditor, bmaps map[string]*ebpf.Map,
-	bufHandler perferEventHandle, enableTLS bool) (*manager.Manager, *sysmonitor.UprobeRegister, error) {
+	bufHandler perferEventHandle, enableTLS, enableGoTLS bool) (*manager.Manager, *sysmonitor.UprobeRegister, error) {
 	randInnerID = newRandFunc()
 
 	m := &manager.Manager{
@@ -269,22 +269,37 @@ func NewHTTPFlowManger(constEditor []manager.ConstantEditor, bmaps map[string]*e
 	}
 
 	var r *sysmonitor.UprobeRegister
-	if enableTLS {
-		opensslRules := []sysmonitor.UprobeRegRule{
-			{
-				Re:         RegexpLibSSL,
-				Register:   sysmonitor.NewRegisterFunc(m, libSSLSection),
-				UnRegister: sysmonitor.NewUnRegisterFunc(m, libSSLSection),
-			},
-			{
-				Re:         RegexpLibCrypto,
-				Register:   sysmonitor.NewRegisterFunc(m, libcryptoSection),
-				UnRegister: sysmonitor.NewUnRegisterFunc(m, libcryptoSection),
-			},
+	if enableTLS || enableGoTLS {
+		var uprobeRules []sysmonitor.UprobeRegRule
+
+		if enableTLS {
+			uprobeRules = append(uprobeRules,
+				sysmonitor.UprobeRegRule{
+					Re:         RegexpLibSSL,
+					Register:   sysmonitor.NewRegisterFunc(m, libSSLSection),
+					UnRegister: sysmonitor.NewUnRegisterFunc(m, libSSLSection),
+				},
+				sysmonitor.UprobeRegRule{
+					Re:         RegexpLibCrypto,
+					Register:   sysmonitor.NewRegisterFunc(m, libcryptoSection),
+					UnRegister: sysmonitor.NewUnRegisterFunc(m, libcryptoSection),
+				},
+			)
+		}
+
+		// Go TLS (crypto/tls) tracing is independent of the libssl/libcrypto
+		// path above: a statically-linked Go service has neither library
+		// loaded, so it must be selectable on its own.
+		if enableGoTLS {
+			uprobeRules = append(uprobeRules, sysmonitor.UprobeRegRule{
+				Re:         RegexpGoBinary,
+				Register:   goTLSRegister,
+				UnRegister: goTLSUnregister,
+			})
 		}
 
 		var err error
-		r, err = sysmonitor.NewUprobeDyncLibRegister(opensslRules)
+		r, err = sysmonitor.NewUprobeDyncLibRegister(uprobeRules)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -350,14 +365,14 @@ func NewHTTPFlowTracer(ctx context.Context, tags map[string]string, datakitPostU
 }
 
 func (tracer *HTTPFlowTracer) Run(ctx context.Context, constEditor []manager.ConstantEditor,
-	bmaps map[string]*ebpf.Map, enableTLS bool, interval time.Duration) error {
+	bmaps map[string]*ebpf.Map, enableTLS, enableGoTLS bool, interval time.Duration) error {
 	if selfPid == 0 {
 		selfPid = os.Getpid()
 	}
 	go tracer.tracer.Start(ctx, interval)
 
 	bpfManger, r, err := NewHTTPFlowManger(constEditor, bmaps,
-		tracer.tracer.PerfEventHandle, enableTLS)
+		tracer.tracer.PerfEventHandle, enableTLS, enableGoTLS)
 	if err != nil {
 		return err
 	}
@@ -35,7 +35,7 @@ type TraceInfo struct {
 
 	ASpanSampled bool
 
-	// TraceProvider string
+	TraceProvider string
 
 	Headers map[string]string
 
@@ -106,8 +106,15 @@ func GetHTTPHeader(payload []byte) map[string]string {
 	return headers
 }
 
+const (
+	TraceProviderDataDog = "datadog"
+	TraceProviderW3C     = "w3c"
+	TraceProviderB3      = "b3"
+	TraceProviderJaeger  = "jaeger"
+)
+
 func GetTraceInfo(headers map[string]string) (sampled bool, hexEnc bool,
-	traceID spanid.ID128, parentID spanid.ID64,
+	traceID spanid.ID128, parentID spanid.ID64, provider string,
 ) {
 	if tid, ok := headers["x-datadog-trace-id"]; ok {
 		traceID.Low = uint64(DecTraceOrSpanid2ID64(tid))
@@ -118,6 +125,7 @@ func GetTraceInfo(headers map[string]string) (sampled bool, hexEnc bool,
 			sampled = SampledDataDog(v)
 		}
 		hexEnc = false
+		provider = TraceProviderDataDog
 	} else if v, ok := headers["traceparent"]; ok {
 		traceParent := strings.Split(v, "-")
 		if len(traceParent) == 4 {
@@ -125,6 +133,49 @@ func GetTraceInfo(headers map[string]string) (sampled bool, hexEnc bool,
 			traceID = HexTraceid2ID128(traceParent[1])
 			parentID = HexSpanid2ID64(traceParent[2])
 			hexEnc = true
+			provider = TraceProviderW3C
+		}
+	} else if tid, ok := headers["x-b3-traceid"]; ok {
+		traceID = HexTraceid2ID128Pad(tid)
+		if psid, ok := headers["x-b3-spanid"]; ok {
+			parentID = HexSpanid2ID64(psid)
+		} else if psid, ok := headers["x-b3-parentspanid"]; ok {
+			parentID = HexSpanid2ID64(psid)
+		}
+		if v, ok := headers["x-b3-sampled"]; ok {
+			sampled = SampledB3(v)
+		}
+		hexEnc = true
+		provider = TraceProviderB3
+	} else if v, ok := headers["b3"]; ok {
+		// traceid-spanid-sampled-parentspanid
+		b3 := strings.Split(v, "-")
+		if len(b3) >= 2 {
+			traceID = HexTraceid2ID128Pad(b3[0])
+			parentID = HexSpanid2ID64(b3[1])
+			if len(b3) >= 3 {
+				sampled = SampledB3(b3[2])
+			}
+			hexEnc = true
+			provider = TraceProviderB3
+		}
+	} else if v, ok := headers["uber-trace-id"]; ok {
+		// traceid:spanid:parentid:flags, flags bit 0x01 is the sampled flag
+		jaeger := strings.Split(v, ":")
+		if len(jaeger) == 4 {
+			traceID = HexTraceid2ID128Pad(jaeger[0])
+			// the span ID is written without a fixed width too, so left-pad
+			// it to 16 hex chars before decoding
+			spanID := jaeger[1]
+			if len(spanID) < 16 {
+				spanID = strings.Repeat("0", 16-len(spanID)) + spanID
+			}
+			parentID = HexSpanid2ID64(spanID)
+			if flags, err := strconv.ParseUint(jaeger[3], 10, 64); err == nil {
+				sampled = flags&0x01 != 0
+			}
+			hexEnc = true
+			provider = TraceProviderJaeger
 		}
 	}
 
@@ -169,6 +220,42 @@ func HexSpanid2ID64(s string) spanid.ID64 {
 	}
 }
 
+// HexTraceid2ID128Pad decodes a B3/Jaeger hex trace ID. Jaeger's Go client in
+// particular formats the trace ID with no fixed width (strconv.FormatUint(id,
+// 16)), so lengths from 1 to 32 hex chars are all valid: the string is
+// left-padded with zeros out to 16 hex chars (64 bit, low bits only) or 32
+// hex chars (128 bit) before decoding, whichever it fits within.
+func HexTraceid2ID128Pad(s string) spanid.ID128 {
+	switch {
+	case len(s) <= 16:
+		s = strings.Repeat("0", 16-len(s)) + s
+	case len(s) <= 32:
+		s = strings.Repeat("0", 32-len(s)) + s
+	default:
+		return spanid.ID128{}
+	}
+
+	b, _ := hex.DecodeString(s)
+	switch len(b) {
+	case 16:
+		return spanid.ID128{
+			Low:  binary.BigEndian.Uint64(b[8:]),
+			High: binary.BigEndian.Uint64(b[:8]),
+		}
+	case 8:
+		return spanid.ID128{
+			Low: binary.BigEndian.Uint64(b),
+		}
+	default:
+		return spanid.ID128{}
+	}
+}
+
+// SampledB3 parses the B3 sampled flag ("1", "0", or the debug flag "d").
+func SampledB3(s string) bool {
+	return s == "1" || s == "d"
+}
+
 type ProcessFilter struct {
 	SvcAssignEnv []string
 	RuleEnv      map[string]bool
@@ -0,0 +1,123 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/GuanceCloud/datakit-ebpf/pkg/spanid"
+)
+
+func TestHexTraceid2ID128Pad(t *testing.T) {
+	cases := []struct {
+		in   string
+		want spanid.ID128
+	}{
+		{in: "", want: spanid.ID128{}},
+		{in: "1", want: spanid.ID128{Low: 0x1}},
+		{in: "a3ce929d0e0e4736", want: spanid.ID128{Low: 0xa3ce929d0e0e4736}},
+		{
+			in:   "463ac35c9f6413ad48485a3953bb6124",
+			want: spanid.ID128{High: 0x463ac35c9f6413ad, Low: 0x48485a3953bb6124},
+		},
+		{in: "zz", want: spanid.ID128{}},                                 // not hex
+		{in: "1234567890123456789012345678901234", want: spanid.ID128{}}, // too long
+	}
+
+	for _, c := range cases {
+		if got := HexTraceid2ID128Pad(c.in); got != c.want {
+			t.Errorf("HexTraceid2ID128Pad(%q) = %+v, want %+v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestSampledB3(t *testing.T) {
+	cases := []struct {
+		in   string
+		want bool
+	}{
+		{"1", true},
+		{"d", true},
+		{"0", false},
+		{"", false},
+	}
+
+	for _, c := range cases {
+		if got := SampledB3(c.in); got != c.want {
+			t.Errorf("SampledB3(%q) = %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestGetTraceInfoB3MultiHeader(t *testing.T) {
+	headers := map[string]string{
+		"x-b3-traceid":      "463ac35c9f6413ad48485a3953bb6124",
+		"x-b3-spanid":       "a3ce929d0e0e4736",
+		"x-b3-parentspanid": "05e3ac9a4f6e3b90",
+		"x-b3-sampled":      "1",
+	}
+
+	sampled, hexEnc, traceID, parentID, provider := GetTraceInfo(headers)
+	if !sampled || !hexEnc || provider != TraceProviderB3 {
+		t.Fatalf("unexpected result: sampled=%v hexEnc=%v provider=%s", sampled, hexEnc, provider)
+	}
+	if traceID.High != 0x463ac35c9f6413ad || traceID.Low != 0x48485a3953bb6124 {
+		t.Errorf("unexpected traceID: %+v", traceID)
+	}
+	// the parent of the span we're about to create is the caller's own span
+	// (x-b3-spanid), not the caller's parent (x-b3-parentspanid)
+	if parentID != 0xa3ce929d0e0e4736 {
+		t.Errorf("unexpected parentID: %x", uint64(parentID))
+	}
+}
+
+func TestGetTraceInfoB3SingleHeader(t *testing.T) {
+	headers := map[string]string{
+		"b3": "463ac35c9f6413ad48485a3953bb6124-a3ce929d0e0e4736-1",
+	}
+
+	sampled, hexEnc, traceID, parentID, provider := GetTraceInfo(headers)
+	if !sampled || !hexEnc || provider != TraceProviderB3 {
+		t.Fatalf("unexpected result: sampled=%v hexEnc=%v provider=%s", sampled, hexEnc, provider)
+	}
+	if traceID.High != 0x463ac35c9f6413ad || traceID.Low != 0x48485a3953bb6124 {
+		t.Errorf("unexpected traceID: %+v", traceID)
+	}
+	if parentID != 0xa3ce929d0e0e4736 {
+		t.Errorf("unexpected parentID: %x", uint64(parentID))
+	}
+}
+
+func TestGetTraceInfoJaeger(t *testing.T) {
+	headers := map[string]string{
+		"uber-trace-id": "463ac35c9f6413ad48485a3953bb6124:a3ce929d0e0e4736:0:1",
+	}
+
+	sampled, hexEnc, traceID, parentID, provider := GetTraceInfo(headers)
+	if !sampled || !hexEnc || provider != TraceProviderJaeger {
+		t.Fatalf("unexpected result: sampled=%v hexEnc=%v provider=%s", sampled, hexEnc, provider)
+	}
+	if traceID.High != 0x463ac35c9f6413ad || traceID.Low != 0x48485a3953bb6124 {
+		t.Errorf("unexpected traceID: %+v", traceID)
+	}
+	if parentID != 0xa3ce929d0e0e4736 {
+		t.Errorf("unexpected parentID: %x", uint64(parentID))
+	}
+}
+
+func TestGetTraceInfoJaegerShortTraceID(t *testing.T) {
+	// a trace ID whose top nibble is zero is emitted without the leading
+	// zero by Jaeger's Go client (strconv.FormatUint(id, 16))
+	headers := map[string]string{
+		"uber-trace-id": "1:2:0:1",
+	}
+
+	sampled, _, traceID, parentID, provider := GetTraceInfo(headers)
+	if !sampled || provider != TraceProviderJaeger {
+		t.Fatalf("unexpected result: sampled=%v provider=%s", sampled, provider)
+	}
+	if traceID.Low != 0x1 || traceID.High != 0 {
+		t.Errorf("unexpected traceID: %+v", traceID)
+	}
+	if parentID != 0x2 {
+		t.Errorf("unexpected parentID: %x", uint64(parentID))
+	}
+}